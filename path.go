@@ -1,11 +1,15 @@
 package pathio
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+
+	"github.com/spf13/afero"
 )
 
 var (
@@ -30,16 +34,39 @@ type IPath interface {
 	FilesByExt(mask string) ([]string, error)
 	DirExists() bool
 	Reset() error
+	Walk(fn WalkFunc, opts ...WalkOptions) error
+	WalkFiles(fn WalkFunc, opts ...WalkOptions) error
+	Watch(ctx context.Context) (<-chan Event, error)
+	Begin() (Tx, error)
 }
 
 type pathIO struct {
-	here   string
-	parent IPath
-	childs map[string]IPath
+	here     string
+	parent   IPath
+	childsMu sync.RWMutex
+	childs   map[string]IPath
+	fs       afero.Fs
+	sanitize *SanitizeOptions
 }
 
 func New(absolutePath string) (IPath, error) {
-	r, err := newPathIO(nil, absolutePath, true)
+	return NewWithFs(afero.NewOsFs(), absolutePath)
+}
+
+func NewWithFs(fs afero.Fs, absolutePath string) (IPath, error) {
+	r, err := newPathIO(fs, nil, absolutePath, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new pathIO: %w", err)
+	}
+	return r, nil
+}
+
+func NewWithOptions(fs afero.Fs, absolutePath string, opts ...Option) (IPath, error) {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	r, err := newPathIO(fs, nil, absolutePath, true, c.sanitize)
 	if err != nil {
 		return nil, fmt.Errorf("error creating new pathIO: %w", err)
 	}
@@ -47,12 +74,18 @@ func New(absolutePath string) (IPath, error) {
 }
 
 func (p *pathIO) CreateChild(childPath string) (IPath, error) {
+	if p.sanitize != nil {
+		childPath = MakePath(childPath, *p.sanitize)
+	}
+	if strings.TrimSpace(childPath) == "" {
+		return nil, ErrFileNameIsEmpty
+	}
 	result, err := p.AttachChild(childPath)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao anexar diretório %s: %v", childPath, err)
 	}
 	if !result.DirExists() {
-		if err := os.Mkdir(result.Here(), 0755); err != nil {
+		if err := p.fs.Mkdir(result.Here(), 0755); err != nil {
 			return nil, fmt.Errorf("falha ao criar diretório %s: %v", result.Here(), err)
 		}
 	}
@@ -79,7 +112,7 @@ func (p *pathIO) AttachChild(childPath string) (IPath, error) {
 	result, ok := p.FindChild(childPath)
 	if !ok {
 		var err error
-		result, err = newPathIO(p, childPath, false)
+		result, err = newPathIO(p.fs, p, childPath, false, nil)
 		if err != nil {
 			return nil, fmt.Errorf("erro criando path %s: %v", childPath, err)
 		}
@@ -100,6 +133,8 @@ func (p *pathIO) Parent() IPath {
 }
 
 func (p *pathIO) Childs() []IPath {
+	p.childsMu.RLock()
+	defer p.childsMu.RUnlock()
 	var result = make([]IPath, len(p.childs))
 	i := 0
 	for _, v := range p.childs {
@@ -110,10 +145,14 @@ func (p *pathIO) Childs() []IPath {
 }
 
 func (p *pathIO) HasChilds() bool {
+	p.childsMu.RLock()
+	defer p.childsMu.RUnlock()
 	return len(p.childs) > 0
 }
 
 func (p *pathIO) FindChild(directory string) (IPath, bool) {
+	p.childsMu.RLock()
+	defer p.childsMu.RUnlock()
 	result, ok := p.childs[directory]
 	return result, ok
 }
@@ -122,11 +161,17 @@ func (p *pathIO) FileName(name string) (string, error) {
 	if strings.TrimSpace(name) == "" {
 		return "", ErrFileNameIsEmpty
 	}
+	if p.sanitize != nil {
+		name = MakePath(name, *p.sanitize)
+		if strings.TrimSpace(name) == "" {
+			return "", ErrFileNameIsEmpty
+		}
+	}
 	return filepath.Join(p.here, name), nil
 }
 
 func (p *pathIO) Files() ([]string, error) {
-	entries, err := os.ReadDir(p.here)
+	entries, err := afero.ReadDir(p.fs, p.here)
 	if err != nil {
 		return nil, fmt.Errorf("erro lendo arquivos: %w", err)
 	}
@@ -144,7 +189,7 @@ func (p *pathIO) Files() ([]string, error) {
 }
 
 func (p *pathIO) FilesByExt(mask string) ([]string, error) {
-	entries, err := os.ReadDir(p.here)
+	entries, err := afero.ReadDir(p.fs, p.here)
 	if err != nil {
 		return nil, fmt.Errorf("erro lendo arquivo do tipo %s: %v", mask, err)
 	}
@@ -164,23 +209,26 @@ func (p *pathIO) FilesByExt(mask string) ([]string, error) {
 }
 
 func (p *pathIO) DirExists() bool {
-	return exists(p.here)
+	return exists(p.fs, p.here)
 }
 
 func (p *pathIO) Reset() error {
 	return p.reset(true)
 }
 
-func newPathIO(parent IPath, path string, requiredValidPath bool) (IPath, error) {
+func newPathIO(fs afero.Fs, parent IPath, path string, requiredValidPath bool, sanitize *SanitizeOptions) (IPath, error) {
 	result := &pathIO{
-		here:   path,
-		parent: parent,
-		childs: make(map[string]IPath),
+		here:     path,
+		parent:   parent,
+		childs:   make(map[string]IPath),
+		fs:       fs,
+		sanitize: sanitize,
 	}
 	if parent != nil {
 		result.here = filepath.Join(parent.Here(), path, "/")
 		x, _ := parent.(*pathIO)
 		x.addChild(result)
+		result.sanitize = x.sanitize
 	}
 	if result.DirExists() {
 		if err := result.attachDirs(); err != nil {
@@ -193,7 +241,7 @@ func newPathIO(parent IPath, path string, requiredValidPath bool) (IPath, error)
 }
 
 func (p *pathIO) attachDirs() error {
-	entries, err := os.ReadDir(p.here)
+	entries, err := afero.ReadDir(p.fs, p.here)
 	if err != nil {
 		return fmt.Errorf("falha ao ler diretório %s: %v", p.here, err)
 	}
@@ -208,29 +256,50 @@ func (p *pathIO) attachDirs() error {
 	return nil
 }
 
-func exists(path string) bool {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
+func exists(fs afero.Fs, path string) bool {
+	if path == "" {
+		return false
+	}
+	if _, err := fs.Stat(path); os.IsNotExist(err) {
 		return false
 	}
 	return true
 }
 
 func (p *pathIO) addChild(path IPath) {
+	p.childsMu.Lock()
+	defer p.childsMu.Unlock()
 	p.childs[path.Relative()] = path
 }
 
+func (p *pathIO) removeChild(name string) {
+	p.childsMu.Lock()
+	defer p.childsMu.Unlock()
+	delete(p.childs, name)
+}
+
 func (p *pathIO) reset(attachDirs bool) error {
-	var sdir *pathIO
+	p.childsMu.RLock()
+	childs := make([]*pathIO, 0, len(p.childs))
 	for _, path := range p.childs {
-		sdir = path.(*pathIO)
-		if sdir == nil {
-			continue
+		if sdir, ok := path.(*pathIO); ok {
+			childs = append(childs, sdir)
 		}
+	}
+	p.childsMu.RUnlock()
+
+	var sdir *pathIO
+	for _, c := range childs {
+		sdir = c
 		if err := sdir.reset(false); err != nil {
 			return fmt.Errorf("fail reset dir: %v", err)
 		}
 	}
+
+	p.childsMu.Lock()
 	clear(p.childs)
+	p.childsMu.Unlock()
+
 	if attachDirs && sdir != nil {
 		return sdir.attachDirs()
 	}
@@ -8,29 +8,44 @@ import (
 	"testing"
 
 	"github.com/conceitho/pathio"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/suite"
 )
 
+// SuiteTest roda o conjunto de cenários abaixo sobre o filesystem configurado
+// em fs/rootDir. Por padrão (zero value) ela usa o filesystem do sistema
+// operacional; TestSuiteMemMapFs reaproveita exatamente os mesmos cenários
+// sobre um afero.MemMapFs.
 type SuiteTest struct {
 	suite.Suite
+	fs       afero.Fs
 	rootDir  string       // Diretório raíz do teste
 	subDir   string       // Subdiretório criado por alguns testes
 	rootPath pathio.IPath // Ponteiro para o path raíz
 }
 
 func (s *SuiteTest) SetupSuite() {
-	s.rootDir = filepath.Join(os.TempDir(), "fluent_tests")
+	if s.fs == nil {
+		s.fs = afero.NewOsFs()
+	}
+	if s.rootDir == "" {
+		s.rootDir = filepath.Join(os.TempDir(), "fluent_tests")
+	}
 	s.subDir = "temp"
 	// Cria diretório raíz de testes
-	if err := os.MkdirAll(s.rootDir, 0755); err != nil {
+	if err := s.fs.MkdirAll(s.rootDir, 0755); err != nil {
+		panic(err)
+	}
+	var err error
+	s.rootPath, err = pathio.NewWithFs(s.fs, s.rootDir)
+	if err != nil {
 		panic(err)
 	}
-	s.rootPath, _ = pathio.New(s.rootDir)
 }
 
 // this function executes after all tests executed
 func (s *SuiteTest) TearDownSuite() {
-	_ = os.RemoveAll(s.rootDir)
+	_ = s.fs.RemoveAll(s.rootDir)
 }
 
 // this function executes before each test case
@@ -44,9 +59,13 @@ func TestSuite(t *testing.T) {
 	suite.Run(t, new(SuiteTest))
 }
 
+func TestSuiteMemMapFs(t *testing.T) {
+	suite.Run(t, &SuiteTest{fs: afero.NewMemMapFs(), rootDir: "/fluent_tests"})
+}
+
 func (s *SuiteTest) TestNew() {
 	expected := s.rootDir
-	p, e := pathio.New(expected)
+	p, e := pathio.NewWithFs(s.fs, expected)
 	s.Nil(e)
 	s.Equal(expected, p.Here())
 }
@@ -62,7 +81,7 @@ func (s *SuiteTest) TestPathIO_DirExists_WhenValidPath_ShouldReturnTrue() {
 
 func (s *SuiteTest) TestPathIO_DirExists_WhenInvalidPath_ShouldReturnFalse() {
 	expected := ""
-	p, e := pathio.New(expected)
+	p, e := pathio.NewWithFs(s.fs, expected)
 	s.Nil(p)
 	s.ErrorIs(errors.Unwrap(e), pathio.ErrPathNotFound)
 }
@@ -207,15 +226,16 @@ func (s *SuiteTest) TestPathIO_Parent_WhenHasParent_ShouldReturnPath() {
 	s.Nil(err)
 	s.NotNil(p)
 	s.Equal(s.subDir, p.Relative())
+	s.Equal(s.rootPath, p.Parent())
 	s.resetRootDir()
 }
 
 func (s *SuiteTest) TestPathIO_AttachChild_WhenHasChilds_ShouldReturnFillList() {
 	// Cria estrutura de diretórios
-	_ = os.Mkdir(filepath.Join(s.rootDir, s.subDir), 0755)
+	_ = s.fs.Mkdir(filepath.Join(s.rootDir, s.subDir), 0755)
 	for i := range 10 {
 		dirName := filepath.Join(s.rootDir, s.subDir, fmt.Sprintf("_%d", i))
-		_ = os.Mkdir(dirName, 0755)
+		_ = s.fs.Mkdir(dirName, 0755)
 	}
 	defer func() {
 		s.resetRootDir()
@@ -228,12 +248,32 @@ func (s *SuiteTest) TestPathIO_AttachChild_WhenHasChilds_ShouldReturnFillList()
 	s.Equal(10, len(c))
 }
 
+func (s *SuiteTest) TestPathIO_Reset_ShouldDropStaleChildsAndAttachNewOnes() {
+	_, err := s.rootPath.CreateChild(s.subDir)
+	s.Nil(err)
+	_, ok := s.rootPath.FindChild(s.subDir)
+	s.True(ok)
+
+	s.Nil(s.fs.RemoveAll(filepath.Join(s.rootDir, s.subDir)))
+	otherDir := "other"
+	s.Nil(s.fs.Mkdir(filepath.Join(s.rootDir, otherDir), 0755))
+
+	s.Nil(s.rootPath.Reset())
+
+	_, ok = s.rootPath.FindChild(s.subDir)
+	s.False(ok)
+	_, ok = s.rootPath.FindChild(otherDir)
+	s.True(ok)
+
+	s.resetRootDir()
+}
+
 func (s *SuiteTest) touchFile(fileName string) error {
 	file, err := s.rootPath.FileName(fileName)
 	if err != nil {
 		return err
 	}
-	f, err := os.OpenFile(file, os.O_RDONLY|os.O_CREATE, 0644)
+	f, err := s.fs.OpenFile(file, os.O_RDONLY|os.O_CREATE, 0644)
 	if err != nil {
 		return err
 	}
@@ -241,7 +281,7 @@ func (s *SuiteTest) touchFile(fileName string) error {
 }
 
 func (s *SuiteTest) resetRootDir() {
-	_ = os.RemoveAll(s.rootDir)
-	_ = os.Mkdir(s.rootDir, 0755)
+	_ = s.fs.RemoveAll(s.rootDir)
+	_ = s.fs.Mkdir(s.rootDir, 0755)
 	_ = s.rootPath.Reset()
 }
@@ -0,0 +1,71 @@
+package pathio
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+type SanitizeOptions struct {
+	RemoveAccents bool
+	ReplaceSpaces rune
+	Lowercase     bool
+	// AllowUnicodeLetters preserva letras fora do alfabeto ASCII (ex.:
+	// cirílico, hangul, devanágari); quando falso, elas são removidas
+	// após o fold de acentos.
+	AllowUnicodeLetters bool
+}
+
+type Option func(*config)
+
+type config struct {
+	sanitize *SanitizeOptions
+}
+
+func WithSanitize(o SanitizeOptions) Option {
+	return func(c *config) {
+		c.sanitize = &o
+	}
+}
+
+var accentFolder = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+func MakePath(name string, opts SanitizeOptions) string {
+	s := strings.TrimSpace(name)
+
+	if opts.RemoveAccents {
+		if folded, _, err := transform.String(accentFolder, s); err == nil {
+			s = folded
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			b.WriteRune(' ')
+		case opts.ReplaceSpaces != 0 && r == opts.ReplaceSpaces:
+			b.WriteRune(r)
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			continue
+		case r > unicode.MaxASCII && unicode.IsLetter(r) && !opts.AllowUnicodeLetters:
+			continue
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	sep := " "
+	if opts.ReplaceSpaces != 0 {
+		sep = string(opts.ReplaceSpaces)
+	}
+	s = strings.Join(strings.Fields(b.String()), sep)
+
+	if opts.Lowercase {
+		s = strings.ToLower(s)
+	}
+	return s
+}
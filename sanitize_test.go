@@ -0,0 +1,95 @@
+package pathio_test
+
+import (
+	"testing"
+
+	"github.com/conceitho/pathio"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteTestSanitize struct {
+	suite.Suite
+}
+
+func TestSuiteSanitize(t *testing.T) {
+	suite.Run(t, new(SuiteTestSanitize))
+}
+
+func (s *SuiteTestSanitize) TestMakePath_ShouldCollapseSpacesAndStripPunctuation() {
+	got := pathio.MakePath("Relatório, Final: 2024", pathio.SanitizeOptions{ReplaceSpaces: '-', AllowUnicodeLetters: true})
+	s.Equal("Relatório-Final-2024", got)
+}
+
+func (s *SuiteTestSanitize) TestMakePath_ShouldFoldAccentsWhenRequested() {
+	got := pathio.MakePath("café com leite", pathio.SanitizeOptions{RemoveAccents: true, ReplaceSpaces: '-', Lowercase: true})
+	s.Equal("cafe-com-leite", got)
+}
+
+func (s *SuiteTestSanitize) TestMakePath_ShouldPreserveNonLatinScripts() {
+	got := pathio.MakePath("проект альфа", pathio.SanitizeOptions{ReplaceSpaces: '-', AllowUnicodeLetters: true})
+	s.Equal("проект-альфа", got)
+}
+
+func (s *SuiteTestSanitize) TestMakePath_ShouldDropNonAsciiLettersWhenNotAllowed() {
+	got := pathio.MakePath("naïve", pathio.SanitizeOptions{})
+	s.Equal("nave", got)
+}
+
+func (s *SuiteTestSanitize) TestNewWithOptions_ShouldSanitizeCreateChildInput() {
+	fs := afero.NewMemMapFs()
+	s.Nil(fs.MkdirAll("/root", 0755))
+	root, err := pathio.NewWithOptions(fs, "/root", pathio.WithSanitize(pathio.SanitizeOptions{
+		RemoveAccents: true,
+		ReplaceSpaces: '-',
+		Lowercase:     true,
+	}))
+	s.Nil(err)
+
+	child, err := root.CreateChild("Relatório Final")
+	s.Nil(err)
+	s.Equal("relatorio-final", child.Relative())
+}
+
+func (s *SuiteTestSanitize) TestNewWithOptions_PreexistingChild_ShouldAlsoInheritSanitize() {
+	fs := afero.NewMemMapFs()
+	s.Nil(fs.MkdirAll("/root/Existing Child", 0755))
+
+	root, err := pathio.NewWithOptions(fs, "/root", pathio.WithSanitize(pathio.SanitizeOptions{
+		RemoveAccents: true,
+		ReplaceSpaces: '-',
+		Lowercase:     true,
+	}))
+	s.Nil(err)
+
+	existing, ok := root.FindChild("Existing Child")
+	s.True(ok)
+
+	child, err := existing.CreateChild("Another Dir")
+	s.Nil(err)
+	s.Equal("another-dir", child.Relative())
+}
+
+func (s *SuiteTestSanitize) TestNewWithOptions_CreateChild_WhenSanitizeCollapsesToEmpty_ShouldError() {
+	fs := afero.NewMemMapFs()
+	s.Nil(fs.MkdirAll("/root", 0755))
+	root, err := pathio.NewWithOptions(fs, "/root", pathio.WithSanitize(pathio.SanitizeOptions{}))
+	s.Nil(err)
+
+	before := len(root.Childs())
+	child, err := root.CreateChild("!!!")
+	s.Nil(child)
+	s.ErrorIs(err, pathio.ErrFileNameIsEmpty)
+	s.Equal(before, len(root.Childs()))
+}
+
+func (s *SuiteTestSanitize) TestNewWithOptions_FileName_WhenSanitizeCollapsesToEmpty_ShouldError() {
+	fs := afero.NewMemMapFs()
+	s.Nil(fs.MkdirAll("/root", 0755))
+	root, err := pathio.NewWithOptions(fs, "/root", pathio.WithSanitize(pathio.SanitizeOptions{}))
+	s.Nil(err)
+
+	file, err := root.FileName("!!!")
+	s.Empty(file)
+	s.ErrorIs(err, pathio.ErrFileNameIsEmpty)
+}
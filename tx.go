@@ -0,0 +1,159 @@
+package pathio
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// ErrTxClosed é retornado quando Commit ou Rollback são chamados em uma Tx
+// que já foi finalizada, ou quando uma operação é tentada após isso.
+var ErrTxClosed = errors.New("transação já foi finalizada")
+
+// Tx agrupa criação de subdiretórios e escrita de arquivos para que possam
+// ser desfeitos juntos: quando Rollback é chamado, todo diretório criado pela
+// transação é removido e todo arquivo sobrescrito é restaurado à sua versão
+// anterior. Uma falha em CreateChild ou WriteFile não desfaz por si só os
+// passos já aplicados; eles permanecem até que Rollback seja chamado.
+type Tx interface {
+	CreateChild(childPath string) (IPath, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Commit() error
+	Rollback() error
+}
+
+type txOpKind int
+
+const (
+	txOpMkdir txOpKind = iota
+	txOpWriteFile
+)
+
+type txOp struct {
+	kind    txOpKind
+	path    string
+	existed bool
+	backup  string
+}
+
+type tx struct {
+	root   *pathIO
+	ops    []txOp
+	closed bool
+}
+
+// Begin abre uma Tx enraizada em p. CreateChild e WriteFile chamados nela
+// são aplicados imediatamente, mas registrados para que Rollback possa
+// desfazê-los.
+func (p *pathIO) Begin() (Tx, error) {
+	return &tx{root: p}, nil
+}
+
+func (t *tx) CreateChild(childPath string) (IPath, error) {
+	if t.closed {
+		return nil, ErrTxClosed
+	}
+	var parent IPath = t.root
+	for _, segment := range strings.Split(filepath.ToSlash(childPath), "/") {
+		if segment == "" {
+			continue
+		}
+		name := segment
+		if pi, ok := parent.(*pathIO); ok && pi.sanitize != nil {
+			name = MakePath(segment, *pi.sanitize)
+		}
+		existed := exists(t.root.fs, filepath.Join(parent.Here(), name))
+		child, err := parent.CreateChild(segment)
+		if err != nil {
+			return nil, fmt.Errorf("tx: falha ao criar %s: %w", segment, err)
+		}
+		if !existed {
+			t.ops = append(t.ops, txOp{kind: txOpMkdir, path: child.Here()})
+		}
+		parent = child
+	}
+	return parent, nil
+}
+
+func (t *tx) WriteFile(name string, data []byte, perm os.FileMode) error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	file, err := t.root.FileName(name)
+	if err != nil {
+		return err
+	}
+	fs := t.root.fs
+	existed := exists(fs, file)
+
+	tmp := file + ".tx-tmp"
+	if err := afero.WriteFile(fs, tmp, data, perm); err != nil {
+		return fmt.Errorf("tx: falha ao gravar arquivo temporário %s: %w", tmp, err)
+	}
+
+	var backup string
+	if existed {
+		backup = file + ".tx-bak"
+		if err := fs.Rename(file, backup); err != nil {
+			return fmt.Errorf("tx: falha ao preservar versão anterior de %s: %w", file, err)
+		}
+	}
+	if err := fs.Rename(tmp, file); err != nil {
+		if existed {
+			_ = fs.Rename(backup, file)
+		}
+		return fmt.Errorf("tx: falha ao substituir %s atomicamente: %w", file, err)
+	}
+
+	t.ops = append(t.ops, txOp{kind: txOpWriteFile, path: file, existed: existed, backup: backup})
+	return nil
+}
+
+// Commit finaliza a transação com sucesso, descartando os backups dos
+// arquivos sobrescritos. Nenhuma das operações já aplicadas é desfeita.
+func (t *tx) Commit() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	t.closed = true
+	for _, op := range t.ops {
+		if op.kind == txOpWriteFile && op.backup != "" {
+			_ = t.root.fs.Remove(op.backup)
+		}
+	}
+	t.ops = nil
+	return nil
+}
+
+// Rollback desfaz, na ordem inversa, todo diretório criado e restaura todo
+// arquivo sobrescrito pela transação.
+func (t *tx) Rollback() error {
+	if t.closed {
+		return ErrTxClosed
+	}
+	t.closed = true
+	var firstErr error
+	for i := len(t.ops) - 1; i >= 0; i-- {
+		op := t.ops[i]
+		switch op.kind {
+		case txOpMkdir:
+			if err := t.root.fs.RemoveAll(op.path); err != nil && firstErr == nil {
+				firstErr = fmt.Errorf("tx: falha ao desfazer diretório %s: %w", op.path, err)
+			}
+		case txOpWriteFile:
+			if op.existed {
+				if err := t.root.fs.Rename(op.backup, op.path); err != nil && firstErr == nil {
+					firstErr = fmt.Errorf("tx: falha ao restaurar arquivo %s: %w", op.path, err)
+				}
+			} else if err := t.root.fs.Remove(op.path); err != nil && firstErr == nil {
+				firstErr = fmt.Errorf("tx: falha ao remover arquivo %s: %w", op.path, err)
+			}
+		}
+	}
+	t.ops = nil
+	return firstErr
+}
@@ -0,0 +1,97 @@
+package pathio_test
+
+import (
+	"testing"
+
+	"github.com/conceitho/pathio"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteTestTx struct {
+	suite.Suite
+	fs       afero.Fs
+	rootDir  string
+	rootPath pathio.IPath
+}
+
+func (s *SuiteTestTx) SetupTest() {
+	s.fs = afero.NewMemMapFs()
+	s.rootDir = "/tx_tests"
+	s.Nil(s.fs.MkdirAll(s.rootDir, 0755))
+	var err error
+	s.rootPath, err = pathio.NewWithFs(s.fs, s.rootDir)
+	s.Nil(err)
+}
+
+func TestSuiteTx(t *testing.T) {
+	suite.Run(t, new(SuiteTestTx))
+}
+
+func (s *SuiteTestTx) TestTx_Commit_ShouldKeepCreatedDirsAndFiles() {
+	txn, err := s.rootPath.Begin()
+	s.Nil(err)
+
+	child, err := txn.CreateChild("a/b")
+	s.Nil(err)
+	s.Nil(txn.WriteFile("a/report.txt", []byte("ok"), 0644))
+	s.Nil(txn.Commit())
+
+	s.True(child.DirExists())
+	ok, err := afero.Exists(s.fs, s.rootDir+"/a/report.txt")
+	s.Nil(err)
+	s.True(ok)
+}
+
+func (s *SuiteTestTx) TestTx_Rollback_ShouldRemoveCreatedSubtree() {
+	txn, err := s.rootPath.Begin()
+	s.Nil(err)
+
+	_, err = txn.CreateChild("a/b/c")
+	s.Nil(err)
+	s.Nil(txn.Rollback())
+
+	ok, err := afero.DirExists(s.fs, s.rootDir+"/a")
+	s.Nil(err)
+	s.False(ok)
+}
+
+func (s *SuiteTestTx) TestTx_Rollback_ShouldRestorePreviousFileContent() {
+	file, err := s.rootPath.FileName("report.txt")
+	s.Nil(err)
+	s.Nil(afero.WriteFile(s.fs, file, []byte("original"), 0644))
+
+	txn, err := s.rootPath.Begin()
+	s.Nil(err)
+	s.Nil(txn.WriteFile("report.txt", []byte("new"), 0644))
+	s.Nil(txn.Rollback())
+
+	content, err := afero.ReadFile(s.fs, file)
+	s.Nil(err)
+	s.Equal("original", string(content))
+}
+
+func (s *SuiteTestTx) TestTx_Rollback_ShouldNotDeletePreexistingDirNotInCache() {
+	s.Nil(s.fs.MkdirAll(s.rootDir+"/existing/precious", 0755))
+	s.Nil(afero.WriteFile(s.fs, s.rootDir+"/existing/precious/data.txt", []byte("keep me"), 0644))
+
+	txn, err := s.rootPath.Begin()
+	s.Nil(err)
+
+	_, err = txn.CreateChild("existing")
+	s.Nil(err)
+	s.Nil(txn.Rollback())
+
+	content, err := afero.ReadFile(s.fs, s.rootDir+"/existing/precious/data.txt")
+	s.Nil(err)
+	s.Equal("keep me", string(content))
+}
+
+func (s *SuiteTestTx) TestTx_AfterCommit_ShouldRejectFurtherUse() {
+	txn, err := s.rootPath.Begin()
+	s.Nil(err)
+	s.Nil(txn.Commit())
+
+	_, err = txn.CreateChild("whatever")
+	s.ErrorIs(err, pathio.ErrTxClosed)
+}
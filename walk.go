@@ -0,0 +1,94 @@
+package pathio
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+var SkipDir = errors.New("pathio: pular este diretório")
+
+type WalkFunc func(path IPath, name string, entry os.DirEntry) error
+
+// Travessia nunca desce por subdiretórios que sejam links simbólicos, pois
+// attachDirs só reconhece diretórios reais.
+type WalkOptions struct {
+	MaxDepth int
+	Include  string
+	Exclude  string
+}
+
+func (o WalkOptions) matches(name string) bool {
+	if o.Include != "" {
+		if ok, _ := filepath.Match(o.Include, name); !ok {
+			return false
+		}
+	}
+	if o.Exclude != "" {
+		if ok, _ := filepath.Match(o.Exclude, name); ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *pathIO) Walk(fn WalkFunc, opts ...WalkOptions) error {
+	return p.walk(fn, walkOptionsOf(opts), 0, true)
+}
+
+func (p *pathIO) WalkFiles(fn WalkFunc, opts ...WalkOptions) error {
+	return p.walk(fn, walkOptionsOf(opts), 0, false)
+}
+
+func walkOptionsOf(opts []WalkOptions) WalkOptions {
+	if len(opts) == 0 {
+		return WalkOptions{}
+	}
+	return opts[0]
+}
+
+func (p *pathIO) walk(fn WalkFunc, o WalkOptions, depth int, includeDirs bool) error {
+	if includeDirs {
+		if err := fn(p, "", nil); err != nil {
+			if errors.Is(err, SkipDir) {
+				return nil
+			}
+			return err
+		}
+	}
+	entries, err := afero.ReadDir(p.fs, p.here)
+	if err != nil {
+		return fmt.Errorf("erro lendo diretório %s: %w", p.here, err)
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if !o.matches(e.Name()) {
+			continue
+		}
+		if err := fn(p, e.Name(), fs.FileInfoToDirEntry(e)); err != nil {
+			if errors.Is(err, SkipDir) {
+				continue
+			}
+			return err
+		}
+	}
+	if o.MaxDepth > 0 && depth+1 >= o.MaxDepth {
+		return nil
+	}
+	for _, child := range p.Childs() {
+		c, ok := child.(*pathIO)
+		if !ok {
+			continue
+		}
+		if err := c.walk(fn, o, depth+1, includeDirs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package pathio_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/conceitho/pathio"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteTestWalk struct {
+	suite.Suite
+	fs       afero.Fs
+	rootDir  string
+	rootPath pathio.IPath
+}
+
+func (s *SuiteTestWalk) SetupTest() {
+	s.fs = afero.NewMemMapFs()
+	s.rootDir = "/walk_tests"
+	s.Nil(s.fs.MkdirAll(s.rootDir+"/a/b", 0755))
+	s.Nil(s.fs.MkdirAll(s.rootDir+"/c", 0755))
+	s.Nil(afero.WriteFile(s.fs, s.rootDir+"/root.txt", []byte("x"), 0644))
+	s.Nil(afero.WriteFile(s.fs, s.rootDir+"/a/a.txt", []byte("x"), 0644))
+	s.Nil(afero.WriteFile(s.fs, s.rootDir+"/a/b/b.csv", []byte("x"), 0644))
+	var err error
+	s.rootPath, err = pathio.NewWithFs(s.fs, s.rootDir)
+	s.Nil(err)
+}
+
+func TestSuiteWalk(t *testing.T) {
+	suite.Run(t, new(SuiteTestWalk))
+}
+
+func (s *SuiteTestWalk) TestWalk_ShouldVisitEveryDirAndFile() {
+	var dirs, files int
+	err := s.rootPath.Walk(func(path pathio.IPath, name string, entry os.DirEntry) error {
+		if name == "" {
+			dirs++
+			return nil
+		}
+		files++
+		return nil
+	})
+	s.Nil(err)
+	s.Equal(4, dirs)
+	s.Equal(3, files)
+}
+
+func (s *SuiteTestWalk) TestWalkFiles_ShouldVisitOnlyFiles() {
+	var names []string
+	err := s.rootPath.WalkFiles(func(path pathio.IPath, name string, entry os.DirEntry) error {
+		names = append(names, name)
+		return nil
+	})
+	s.Nil(err)
+	s.ElementsMatch([]string{"root.txt", "a.txt", "b.csv"}, names)
+}
+
+func (s *SuiteTestWalk) TestWalkFiles_WithIncludeGlob_ShouldFilterByPattern() {
+	var names []string
+	err := s.rootPath.WalkFiles(func(path pathio.IPath, name string, entry os.DirEntry) error {
+		names = append(names, name)
+		return nil
+	}, pathio.WalkOptions{Include: "*.txt"})
+	s.Nil(err)
+	s.ElementsMatch([]string{"root.txt", "a.txt"}, names)
+}
+
+func (s *SuiteTestWalk) TestWalk_WithMaxDepth_ShouldNotDescendPastLimit() {
+	var dirs int
+	err := s.rootPath.Walk(func(path pathio.IPath, name string, entry os.DirEntry) error {
+		if name == "" {
+			dirs++
+		}
+		return nil
+	}, pathio.WalkOptions{MaxDepth: 1})
+	s.Nil(err)
+	s.Equal(1, dirs)
+}
+
+func (s *SuiteTestWalk) TestWalk_WhenFnReturnsSkipDir_ShouldPruneSubtree() {
+	var dirs []string
+	err := s.rootPath.Walk(func(path pathio.IPath, name string, entry os.DirEntry) error {
+		if name == "" {
+			if path.Relative() == "a" {
+				return pathio.SkipDir
+			}
+			dirs = append(dirs, path.Relative())
+		}
+		return nil
+	})
+	s.Nil(err)
+	s.NotContains(dirs, "b")
+}
@@ -0,0 +1,153 @@
+package pathio
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+type EventType int
+
+const (
+	Created EventType = iota
+	Removed
+	Renamed
+	Modified
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Created:
+		return "Created"
+	case Removed:
+		return "Removed"
+	case Renamed:
+		return "Renamed"
+	case Modified:
+		return "Modified"
+	default:
+		return "Unknown"
+	}
+}
+
+// Path é o diretório afetado (o próprio, quando criado/removido/renomeado;
+// o pai, quando o afetado é um arquivo). Name é o nome do arquivo quando a
+// mudança é sobre um arquivo, e vazio quando é sobre o próprio diretório.
+type Event struct {
+	Type EventType
+	Path IPath
+	Name string
+}
+
+func (p *pathIO) Watch(ctx context.Context) (<-chan Event, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("erro criando watcher: %w", err)
+	}
+	if err := p.addWatchRecursive(watcher); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+	events := make(chan Event)
+	go p.watchLoop(ctx, watcher, events)
+	return events, nil
+}
+
+func (p *pathIO) addWatchRecursive(w *fsnotify.Watcher) error {
+	if err := w.Add(p.here); err != nil {
+		return fmt.Errorf("erro observando diretório %s: %w", p.here, err)
+	}
+	for _, c := range p.Childs() {
+		cp, ok := c.(*pathIO)
+		if !ok {
+			continue
+		}
+		if err := cp.addWatchRecursive(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pathIO) watchLoop(ctx context.Context, w *fsnotify.Watcher, out chan<- Event) {
+	defer close(out)
+	defer func() { _ = w.Close() }()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			if !p.handleFsnotifyEvent(ctx, w, ev, out) {
+				return
+			}
+		case _, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+func (p *pathIO) handleFsnotifyEvent(ctx context.Context, w *fsnotify.Watcher, ev fsnotify.Event, out chan<- Event) bool {
+	dir := filepath.Dir(ev.Name)
+	name := filepath.Base(ev.Name)
+	owner := p.findByHere(dir)
+	if owner == nil {
+		owner = p
+	}
+
+	switch {
+	case ev.Has(fsnotify.Create):
+		if info, err := owner.fs.Stat(ev.Name); err == nil && info.IsDir() {
+			if child, err := newPathIO(owner.fs, owner, name, false, nil); err == nil {
+				_ = w.Add(child.Here())
+				return sendEvent(ctx, out, Event{Type: Created, Path: child})
+			}
+		}
+		return sendEvent(ctx, out, Event{Type: Created, Path: owner, Name: name})
+	case ev.Has(fsnotify.Remove), ev.Has(fsnotify.Rename):
+		typ := Removed
+		if ev.Has(fsnotify.Rename) {
+			typ = Renamed
+		}
+		if child, ok := owner.FindChild(name); ok {
+			_ = w.Remove(child.Here())
+			owner.removeChild(name)
+			return sendEvent(ctx, out, Event{Type: typ, Path: child})
+		}
+		return sendEvent(ctx, out, Event{Type: typ, Path: owner, Name: name})
+	case ev.Has(fsnotify.Write):
+		return sendEvent(ctx, out, Event{Type: Modified, Path: owner, Name: name})
+	}
+	return true
+}
+
+func sendEvent(ctx context.Context, out chan<- Event, ev Event) bool {
+	select {
+	case out <- ev:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (p *pathIO) findByHere(here string) *pathIO {
+	if p.here == here {
+		return p
+	}
+	for _, c := range p.Childs() {
+		cp, ok := c.(*pathIO)
+		if !ok {
+			continue
+		}
+		if found := cp.findByHere(here); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package pathio_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/conceitho/pathio"
+	"github.com/stretchr/testify/suite"
+)
+
+type SuiteTestWatch struct {
+	suite.Suite
+	rootDir  string
+	rootPath pathio.IPath
+}
+
+func (s *SuiteTestWatch) SetupTest() {
+	s.rootDir = filepath.Join(os.TempDir(), "pathio_watch_tests")
+	s.Nil(os.MkdirAll(s.rootDir, 0755))
+	var err error
+	s.rootPath, err = pathio.New(s.rootDir)
+	s.Nil(err)
+}
+
+func (s *SuiteTestWatch) TearDownTest() {
+	_ = os.RemoveAll(s.rootDir)
+}
+
+func TestSuiteWatch(t *testing.T) {
+	suite.Run(t, new(SuiteTestWatch))
+}
+
+func (s *SuiteTestWatch) TestWatch_WhenSubdirCreated_ShouldEmitCreatedAndUpdateChilds() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	events, err := s.rootPath.Watch(ctx)
+	s.Nil(err)
+
+	s.Nil(os.Mkdir(filepath.Join(s.rootDir, "new_dir"), 0755))
+
+	select {
+	case ev := <-events:
+		s.Equal(pathio.Created, ev.Type)
+		s.NotNil(ev.Path)
+		s.Equal("new_dir", ev.Path.Relative())
+	case <-time.After(time.Second):
+		s.Fail("timed out waiting for Created event")
+	}
+
+	_, ok := s.rootPath.FindChild("new_dir")
+	s.True(ok)
+}
+
+func (s *SuiteTestWatch) TestWatch_WhenConsumerStopsDrainingAndCtxCanceled_ShouldNotBlockForever() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events, err := s.rootPath.Watch(ctx)
+	s.Nil(err)
+
+	s.Nil(os.Mkdir(filepath.Join(s.rootDir, "another_dir"), 0755))
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		s.False(ok, "expected events channel to be closed after ctx cancellation")
+	case <-time.After(time.Second):
+		s.Fail("watchLoop stayed parked on the event send after ctx was canceled")
+	}
+}
+
+func (s *SuiteTestWatch) TestWatch_ConcurrentChildsReadsWhileWatching_ShouldNotRace() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	events, err := s.rootPath.Watch(ctx)
+	s.Nil(err)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					s.rootPath.Childs()
+					s.rootPath.HasChilds()
+					_, _ = s.rootPath.FindChild("some_dir")
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 10; i++ {
+		s.Nil(os.Mkdir(filepath.Join(s.rootDir, fmt.Sprintf("concurrent_dir_%d", i)), 0755))
+	}
+
+	timeout := time.After(time.Second)
+	for received := 0; received < 10; {
+		select {
+		case <-events:
+			received++
+		case <-timeout:
+			received = 10
+		}
+	}
+
+	close(stop)
+	wg.Wait()
+}